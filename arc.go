@@ -0,0 +1,323 @@
+package sieve
+
+import "container/list"
+
+// arcEntry stores cache data (key-value pair) held in the T1/T2 lists. B1
+// and B2 only ever hold the key, so value is left zero there.
+type arcEntry[K comparable, V Value] struct {
+	key   K
+	value V
+}
+
+// arcList identifies which of the four ARC lists currently holds a key.
+type arcList int
+
+const (
+	arcT1 arcList = iota + 1 // recency: seen once recently
+	arcT2                    // frequency: seen at least twice recently
+	arcB1                    // ghost entries recently evicted from T1
+	arcB2                    // ghost entries recently evicted from T2
+)
+
+// ARCCache is a Policy[K,V] implementation of the Adaptive Replacement
+// Cache (Megiddo & Modha): a recency list T1 and a frequency list T2, each
+// backed by a ghost list (B1, B2) of keys recently evicted from it. The
+// target size p of T1 adapts on every ghost-list hit, so the cache leans
+// towards whichever of recency or frequency the workload favors - see the
+// Policy doc comment for the locking and Set-on-existing-key guarantees
+// shared across implementations.
+type ARCCache[K Key, V Value] struct {
+	capacity int64
+	p        int64 // target size of T1
+
+	t1, t2, b1, b2 *list.List
+	index          map[K]*list.Element
+	in             map[K]arcList
+}
+
+// NewARCCache initializes a new ARC cache with the given total capacity,
+// shared between the recency and frequency lists.
+func NewARCCache[K Key, V Value](capacity int64) *ARCCache[K, V] {
+	c := &ARCCache[K, V]{capacity: capacity}
+	c.init()
+	return c
+}
+
+func (c *ARCCache[K, V]) init() {
+	c.p = 0
+	c.t1 = list.New()
+	c.t2 = list.New()
+	c.b1 = list.New()
+	c.b2 = list.New()
+	c.index = make(map[K]*list.Element, c.capacity)
+	c.in = make(map[K]arcList, c.capacity)
+}
+
+// Size returns the current number of items actually cached (T1+T2, not
+// counting ghost entries).
+func (c *ARCCache[K, V]) Size() int64 {
+	return int64(c.t1.Len() + c.t2.Len())
+}
+
+// Capacity returns the maximum number of items the cache can hold.
+func (c *ARCCache[K, V]) Capacity() int64 {
+	return c.capacity
+}
+
+// Get retrieves the value for a given key and promotes it to the frequency
+// list, returning an error if the key is not currently cached (a ghost
+// entry in B1/B2 counts as not found, since no value is stored for it).
+func (c *ARCCache[K, V]) Get(key K) (V, error) {
+	var value V
+	where, ok := c.in[key]
+	if !ok || (where != arcT1 && where != arcT2) {
+		return value, ErrKeyNotFound
+	}
+	ele := c.index[key]
+	entry := ele.Value.(*arcEntry[K, V])
+	value = entry.value
+
+	if where == arcT1 {
+		c.t1.Remove(ele)
+	} else {
+		c.t2.Remove(ele)
+	}
+	c.in[key] = arcT2
+	c.index[key] = c.t2.PushFront(entry)
+	return value, nil
+}
+
+// Set adds key-value to the cache, following the ARC replacement policy:
+// a hit in the recency/frequency lists keeps the stored value and only
+// promotes the entry, a hit in a ghost list adapts p before admitting the
+// entry, and a miss makes room as in ARC's case IV (dropping an LRU entry
+// or running REPLACE) before admitting it into T1.
+func (c *ARCCache[K, V]) Set(key K, value V) {
+	if c.capacity <= 0 {
+		return
+	}
+
+	switch c.in[key] {
+	case arcT1:
+		// Already cached: per the Policy contract, this is a no-op on the
+		// value - it only promotes the entry to T2.
+		ele := c.index[key]
+		entry := ele.Value.(*arcEntry[K, V])
+		c.t1.Remove(ele)
+		c.in[key] = arcT2
+		c.index[key] = c.t2.PushFront(entry)
+		return
+	case arcT2:
+		ele := c.index[key]
+		entry := ele.Value.(*arcEntry[K, V])
+		c.t2.Remove(ele)
+		c.index[key] = c.t2.PushFront(entry)
+		return
+	case arcB1:
+		b1Len, b2Len := int64(c.b1.Len()), int64(c.b2.Len())
+		delta := int64(1)
+		if b1Len > 0 && b2Len/b1Len > delta {
+			delta = b2Len / b1Len
+		}
+		c.p = min64(c.p+delta, c.capacity)
+		c.replace(key)
+		c.b1.Remove(c.index[key])
+		c.in[key] = arcT2
+		c.index[key] = c.t2.PushFront(&arcEntry[K, V]{key: key, value: value})
+		return
+	case arcB2:
+		b1Len, b2Len := int64(c.b1.Len()), int64(c.b2.Len())
+		delta := int64(1)
+		if b2Len > 0 && b1Len/b2Len > delta {
+			delta = b1Len / b2Len
+		}
+		c.p = max64(c.p-delta, 0)
+		c.replace(key)
+		c.b2.Remove(c.index[key])
+		c.in[key] = arcT2
+		c.index[key] = c.t2.PushFront(&arcEntry[K, V]{key: key, value: value})
+		return
+	}
+
+	// Case IV: key is not present in any of the four lists.
+	t1Len, b1Len := int64(c.t1.Len()), int64(c.b1.Len())
+	t2Len, b2Len := int64(c.t2.Len()), int64(c.b2.Len())
+
+	if t1Len+b1Len == c.capacity {
+		if t1Len < c.capacity {
+			c.dropGhost(c.b1)
+			c.replace(key)
+		} else {
+			c.dropLRU(c.t1)
+		}
+	} else if t1Len+b1Len < c.capacity && t1Len+t2Len+b1Len+b2Len >= c.capacity {
+		if t1Len+t2Len+b1Len+b2Len >= 2*c.capacity {
+			c.dropGhost(c.b2)
+		}
+		c.replace(key)
+	}
+
+	c.in[key] = arcT1
+	c.index[key] = c.t1.PushFront(&arcEntry[K, V]{key: key, value: value})
+}
+
+// replace implements ARC's REPLACE(x, p): it moves the LRU entry of T1 to
+// B1, or the LRU entry of T2 to B2, depending on which list currently
+// exceeds its target share. key is the key about to be admitted, which
+// biases the choice towards T1 when it sits at exactly the T1 target and
+// was just found in B2 (per the original algorithm).
+func (c *ARCCache[K, V]) replace(key K) {
+	t1Len := int64(c.t1.Len())
+	biasT1 := c.in[key] == arcB2
+	if t1Len > 0 && (t1Len > c.p || (biasT1 && t1Len == c.p)) {
+		c.moveLRUToGhost(c.t1, c.b1, arcB1)
+	} else if c.t2.Len() > 0 {
+		c.moveLRUToGhost(c.t2, c.b2, arcB2)
+	} else if t1Len > 0 {
+		c.moveLRUToGhost(c.t1, c.b1, arcB1)
+	}
+}
+
+// moveLRUToGhost evicts the LRU entry of from and records its key in
+// ghost, tagged with ghostList.
+func (c *ARCCache[K, V]) moveLRUToGhost(from, ghost *list.List, ghostList arcList) {
+	ele := from.Back()
+	if ele == nil {
+		return
+	}
+	entry := ele.Value.(*arcEntry[K, V])
+	from.Remove(ele)
+	c.in[entry.key] = ghostList
+	c.index[entry.key] = ghost.PushFront(&arcEntry[K, V]{key: entry.key})
+}
+
+// dropLRU evicts the LRU entry of from entirely, forgetting the key.
+func (c *ARCCache[K, V]) dropLRU(from *list.List) {
+	ele := from.Back()
+	if ele == nil {
+		return
+	}
+	entry := ele.Value.(*arcEntry[K, V])
+	from.Remove(ele)
+	delete(c.in, entry.key)
+	delete(c.index, entry.key)
+}
+
+// dropGhost evicts the LRU entry of a ghost list entirely.
+func (c *ARCCache[K, V]) dropGhost(ghost *list.List) {
+	c.dropLRU(ghost)
+}
+
+// Delete removes a key from the cache (T1 or T2) and returns its value, or
+// an error if it is not currently cached.
+func (c *ARCCache[K, V]) Delete(key K) (V, error) {
+	var value V
+	where, ok := c.in[key]
+	if !ok || (where != arcT1 && where != arcT2) {
+		return value, ErrKeyNotFound
+	}
+	ele := c.index[key]
+	entry := ele.Value.(*arcEntry[K, V])
+	value = entry.value
+	if where == arcT1 {
+		c.t1.Remove(ele)
+	} else {
+		c.t2.Remove(ele)
+	}
+	delete(c.in, key)
+	delete(c.index, key)
+	return value, nil
+}
+
+// Evict removes one entry from the cache, preferring T1 over T2 exactly as
+// REPLACE would, and returns its key.
+func (c *ARCCache[K, V]) Evict() (K, error) {
+	var key K
+	if c.t1.Len() == 0 && c.t2.Len() == 0 {
+		return key, ErrEmptyCache
+	}
+	var from *list.List
+	if int64(c.t1.Len()) > c.p || c.t2.Len() == 0 {
+		from = c.t1
+	} else {
+		from = c.t2
+	}
+	ele := from.Back()
+	entry := ele.Value.(*arcEntry[K, V])
+	from.Remove(ele)
+	delete(c.in, entry.key)
+	delete(c.index, entry.key)
+	return entry.key, nil
+}
+
+// Contains checks whether the key is currently cached (ghost entries in
+// B1/B2 do not count).
+func (c *ARCCache[K, V]) Contains(key K) bool {
+	where, ok := c.in[key]
+	return ok && (where == arcT1 || where == arcT2)
+}
+
+// Resize changes the total capacity of the cache, evicting items from T1
+// and T2 if necessary.
+func (c *ARCCache[K, V]) Resize(newCapacity int64) []K {
+	var evictedKeys []K
+	if newCapacity >= c.capacity {
+		c.capacity = newCapacity
+		return evictedKeys
+	}
+	toEvict := c.Size() - newCapacity
+	for toEvict > 0 {
+		if key, err := c.Evict(); err == nil {
+			evictedKeys = append(evictedKeys, key)
+		}
+		toEvict--
+	}
+	c.capacity = newCapacity
+	if c.p > c.capacity {
+		c.p = c.capacity
+	}
+	return evictedKeys
+}
+
+// Keys returns a slice of all keys currently cached (T1+T2).
+func (c *ARCCache[K, V]) Keys() []K {
+	var keys []K
+	for k, where := range c.in {
+		if where == arcT1 || where == arcT2 {
+			keys = append(keys, k)
+		}
+	}
+	return keys
+}
+
+// Items returns a slice of all key-value pairs currently cached (T1+T2).
+func (c *ARCCache[K, V]) Items() []Item[K, V] {
+	var items []Item[K, V]
+	for k, where := range c.in {
+		if where != arcT1 && where != arcT2 {
+			continue
+		}
+		entry := c.index[k].Value.(*arcEntry[K, V])
+		items = append(items, Item[K, V]{Key: k, Value: entry.value})
+	}
+	return items
+}
+
+// Clear resets the cache to its initial empty state.
+func (c *ARCCache[K, V]) Clear() {
+	c.init()
+}
+
+func min64(a, b int64) int64 {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func max64(a, b int64) int64 {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,89 @@
+package sieve
+
+import "time"
+
+// MGet retrieves the values for multiple keys in a single locked pass,
+// returning only the keys that were found (and not expired). Each hit
+// marks its entry visited, just like Get.
+func (c *Cache[K, V]) MGet(keys []K) map[K]V {
+	c.mu.Lock()
+	result := make(map[K]V, len(keys))
+	for _, key := range keys {
+		if value, ok := c.getLocked(key); ok {
+			result[key] = value
+		}
+	}
+	evKeys, evValues := c.takeEvicted()
+	hitKeys, missKeys := c.takeHitMiss()
+	c.mu.Unlock()
+	c.notifyEvicted(evKeys, evValues)
+	c.notifyHitMiss(hitKeys, missKeys)
+	return result
+}
+
+// MSet adds multiple key-value pairs to the cache in a single locked pass,
+// evicting entries as necessary, and flushes any eviction callbacks once
+// afterwards rather than once per item.
+func (c *Cache[K, V]) MSet(items []Item[K, V]) {
+	c.mu.Lock()
+	if c.capacity <= 0 {
+		c.mu.Unlock()
+		return
+	}
+	for _, item := range items {
+		if _, ok := c.lookupLocked(item.Key); ok {
+			continue // If key already exists, skip inserting
+		}
+		if c.size == c.capacity {
+			c.evict()
+		}
+		entry := &nodeEntry[K, V]{key: item.Key, value: item.Value}
+		if c.defaultTTL > 0 {
+			entry.expireAt = time.Now().Add(c.defaultTTL)
+		}
+		element := c.q.PushFront(entry)
+		c.keysMap[item.Key] = element
+		c.size++
+		c.stats.insertions.Add(1)
+	}
+	keys, values := c.takeEvicted()
+	c.mu.Unlock()
+	c.notifyEvicted(keys, values)
+}
+
+// Peek retrieves the value for a given key without marking it visited, so
+// inspecting the cache for debugging, stats, or snapshotting does not
+// distort the SIEVE eviction order. It returns ErrKeyNotFound if the key is
+// absent or has expired.
+func (c *Cache[K, V]) Peek(key K) (V, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var value V
+	ele, ok := c.keysMap[key]
+	if !ok {
+		return value, ErrKeyNotFound
+	}
+	entry := ele.Value.(*nodeEntry[K, V])
+	if isExpired(entry) {
+		return value, ErrKeyNotFound
+	}
+	return entry.value, nil
+}
+
+// Range iterates over the cache in SIEVE list order (front to back),
+// calling f for each non-expired entry without marking it visited. It
+// stops early if f returns false. Range is the basis for snapshotting,
+// persistence, and metrics exporters that must not perturb eviction order.
+func (c *Cache[K, V]) Range(f func(K, V) bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for curr := c.q.Front(); curr != nil; curr = curr.Next() {
+		entry := curr.Value.(*nodeEntry[K, V])
+		if isExpired(entry) {
+			continue
+		}
+		if !f(entry.key, entry.value) {
+			return
+		}
+	}
+}
@@ -0,0 +1,76 @@
+package sieve
+
+import "testing"
+
+func TestCache_MGet(t *testing.T) {
+	cache := NewCache[string, string](5)
+	cache.Set("a", "A")
+	cache.Set("b", "B")
+
+	result := cache.MGet([]string{"a", "b", "missing"})
+	assertEqual(t, 2, len(result), "")
+	assertEqual(t, "A", result["a"], "")
+	assertEqual(t, "B", result["b"], "")
+	if _, ok := result["missing"]; ok {
+		t.Errorf("expected missing key to be absent from the result map")
+	}
+}
+
+func TestCache_MSet(t *testing.T) {
+	cache := NewCache[string, string](2)
+	cache.MSet([]Item[string, string]{
+		{Key: "a", Value: "A"},
+		{Key: "b", Value: "B"},
+		{Key: "c", Value: "C"}, // evicts "a"
+	})
+
+	assertEqual(t, int64(2), cache.Size(), "")
+	_, err := cache.Get("a")
+	assertErrorEqual(t, ErrKeyNotFound, err)
+
+	// Re-Setting an already-present key via MSet is a no-op, per Policy.
+	cache.MSet([]Item[string, string]{{Key: "b", Value: "updated"}})
+	value, err := cache.Get("b")
+	assertErrorNil(t, err)
+	assertEqual(t, "B", value, "MSet should not overwrite an existing value")
+}
+
+func TestCache_Peek(t *testing.T) {
+	cache := NewCache[string, string](5)
+	cache.Set("a", "A")
+	cache.Set("b", "B")
+
+	// Peek a, the oldest entry, then let eviction run - since Peek must not
+	// mark a visited, the hand takes a rather than skipping past it to b.
+	value, err := cache.Peek("a")
+	assertErrorNil(t, err)
+	assertEqual(t, "A", value, "")
+
+	_, err = cache.Peek("missing")
+	assertErrorEqual(t, ErrKeyNotFound, err)
+
+	evictedKey, err := cache.Evict()
+	assertErrorNil(t, err)
+	assertEqual(t, "a", evictedKey, "Peek must not protect an entry from eviction")
+}
+
+func TestCache_Range(t *testing.T) {
+	cache := NewCache[string, string](5)
+	cache.Set("a", "A")
+	cache.Set("b", "B")
+	cache.Set("c", "C")
+
+	var visited []string
+	cache.Range(func(k, v string) bool {
+		visited = append(visited, k)
+		return true
+	})
+	assertEqualSlice(t, []string{"a", "b", "c"}, visited, "")
+
+	var stoppedAfter []string
+	cache.Range(func(k, v string) bool {
+		stoppedAfter = append(stoppedAfter, k)
+		return false
+	})
+	assertEqual(t, 1, len(stoppedAfter), "Range should stop as soon as f returns false")
+}
@@ -4,6 +4,9 @@ import (
 	"container/list"
 	"errors"
 	"fmt"
+	"strings"
+	"sync"
+	"time"
 )
 
 var (
@@ -12,6 +15,11 @@ var (
 	ErrInvalidCapacity = errors.New("capacity should be greater than 0")
 )
 
+// DefaultEvictedBufferSize is the default capacity of the buffer used to
+// stage evicted entries before the onEvicted callback is invoked outside
+// of the cache's lock.
+const DefaultEvictedBufferSize = 16
+
 type Key comparable // Represents the type of the key in the cache, must support comparison
 type Value any      // Represents the type of the value in the cache, can be any type
 
@@ -20,9 +28,10 @@ type keyNodeMap[K Key, V *list.Element] map[K]V
 
 // nodeEntry stores cache data (key-value pair) and the visited flag for eviction
 type nodeEntry[K comparable, V Value] struct {
-	visited bool // Tracks whether this entry has been visited during eviction cycle
-	key     K    // The key for this entry
-	value   V    // The value for this entry
+	visited  bool      // Tracks whether this entry has been visited during eviction cycle
+	key      K         // The key for this entry
+	value    V         // The value for this entry
+	expireAt time.Time // Zero value means the entry never expires
 }
 
 // Item represents a key-value pair for exporting cache data
@@ -31,13 +40,27 @@ type Item[K Key, V Value] struct {
 	Value V // The value in the cache
 }
 
-// Cache represents a Sieve cache with a given capacity
+// Cache represents a Sieve cache with a given capacity. It is safe for
+// concurrent use by multiple goroutines.
 type Cache[K Key, V Value] struct {
+	mu       sync.Mutex
 	capacity int64                        // The maximum number of items the cache can hold
 	size     int64                        // The current number of items in the cache
 	q        *list.List                   // Doubly linked list to maintain cache order
 	keysMap  keyNodeMap[K, *list.Element] // Map of keys to their respective list elements
 	hand     *list.Element                // Points to the hand in the cache, used for eviction tracking
+
+	onEvicted     func(K, V) // Optional callback invoked for every entry removed from the cache
+	onHit         func(K)    // Optional callback invoked for every cache hit
+	onMiss        func(K)    // Optional callback invoked for every cache miss
+	evictedKeys   []K        // Buffer of keys evicted during the current operation, flushed after unlocking
+	evictedValues []V        // Buffer of values evicted during the current operation, flushed after unlocking
+	hitKeys       []K        // Buffer of keys hit during the current operation, flushed after unlocking
+	missKeys      []K        // Buffer of keys missed during the current operation, flushed after unlocking
+
+	defaultTTL time.Duration // TTL applied by Set when non-zero; see NewCacheWithDefaultTTL
+	janitor    *janitor      // Background goroutine reclaiming expired entries, if started
+	stats      statsCounters // Atomic counters backing Stats
 }
 
 // NewCache initializes a new cache with the given capacity
@@ -47,49 +70,181 @@ func NewCache[K Key, V Value](capacity int64) *Cache[K, V] {
 	return cache
 }
 
+// NewCacheWithEvict initializes a new cache with the given capacity and
+// registers onEvicted to be called, outside of the cache's internal lock,
+// whenever an entry is removed from the cache - whether by Set overflow,
+// Evict, Delete, Resize shrinkage, or Clear.
+func NewCacheWithEvict[K Key, V Value](capacity int64, onEvicted func(K, V)) *Cache[K, V] {
+	cache := NewCache[K, V](capacity)
+	cache.onEvicted = onEvicted
+	return cache
+}
+
 // Size returns the current number of items in the cache
 func (c *Cache[K, V]) Size() int64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
 	return c.size
 }
 
 // Capacity returns the maximum capacity of the cache
 func (c *Cache[K, V]) Capacity() int64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
 	return c.capacity
 }
 
-// Set adds a new key-value pair to the cache, evicting an entry if necessary
+// Set adds a new key-value pair to the cache, evicting an entry if
+// necessary. If the cache was created with NewCacheWithDefaultTTL, the
+// entry expires after that duration; use SetWithTTL to override it per key.
 func (c *Cache[K, V]) Set(key K, value V) {
+	c.setWithTTL(key, value, c.defaultTTL)
+}
+
+// setWithTTL adds key-value to the cache with the given ttl (zero means no
+// expiry), evicting an entry if necessary.
+func (c *Cache[K, V]) setWithTTL(key K, value V, ttl time.Duration) {
+	c.mu.Lock()
 	if c.capacity <= 0 {
+		c.mu.Unlock()
 		return
 	}
-	if _, err := c.Get(key); err == nil {
+	if _, ok := c.lookupLocked(key); ok {
+		c.mu.Unlock()
 		return // If key already exists, skip inserting
 	}
 	if c.size == c.capacity {
 		c.evict()
 	}
-	element := c.q.PushFront(&nodeEntry[K, V]{key: key, value: value})
+	entry := &nodeEntry[K, V]{key: key, value: value}
+	if ttl > 0 {
+		entry.expireAt = time.Now().Add(ttl)
+	}
+	element := c.q.PushFront(entry)
 	c.keysMap[key] = element
 	c.size++
+	c.stats.insertions.Add(1)
+	keys, values := c.takeEvicted()
+	c.mu.Unlock()
+	c.notifyEvicted(keys, values)
 }
 
 // Get retrieves the value for a given key, returns an error if the key is not found
 func (c *Cache[K, V]) Get(key K) (V, error) {
+	c.mu.Lock()
+	value, ok := c.getLocked(key)
+	hitKeys, missKeys := c.takeHitMiss()
+	c.mu.Unlock()
+	c.notifyHitMiss(hitKeys, missKeys)
+	if !ok {
+		return value, ErrKeyNotFound
+	}
+	return value, nil
+}
+
+// takeHitMiss returns the currently buffered hit/miss keys and resets the
+// buffers. It must be called with c.mu held.
+func (c *Cache[K, V]) takeHitMiss() ([]K, []K) {
+	var hitKeys, missKeys []K
+	if len(c.hitKeys) > 0 {
+		hitKeys = c.hitKeys
+		c.hitKeys = nil
+	}
+	if len(c.missKeys) > 0 {
+		missKeys = c.missKeys
+		c.missKeys = nil
+	}
+	return hitKeys, missKeys
+}
+
+// notifyHitMiss invokes the onHit/onMiss callbacks for each buffered key.
+// It must be called without c.mu held.
+func (c *Cache[K, V]) notifyHitMiss(hitKeys, missKeys []K) {
+	if c.onHit != nil {
+		for _, k := range hitKeys {
+			c.onHit(k)
+		}
+	}
+	if c.onMiss != nil {
+		for _, k := range missKeys {
+			c.onMiss(k)
+		}
+	}
+}
+
+// getLocked retrieves the value for a given key and marks it visited,
+// recording a hit or miss in c.stats. It must be called with c.mu held.
+func (c *Cache[K, V]) getLocked(key K) (V, bool) {
+	value, ok := c.lookupLocked(key)
+	if ok {
+		c.recordHit(key)
+		return value, true
+	}
+	c.recordMiss(key)
+	return value, false
+}
+
+// lookupLocked retrieves the value for a given key and marks it visited,
+// without touching hit/miss stats; used internally by operations (like
+// Set) that need an existence check rather than a user-facing Get. It must
+// be called with c.mu held.
+func (c *Cache[K, V]) lookupLocked(key K) (V, bool) {
 	var value V
 	ele, ok := c.keysMap[key]
 	if !ok {
-		return value, ErrKeyNotFound // Return error if key not found
+		return value, false
 	}
 	entry := ele.Value.(*nodeEntry[K, V])
+	if isExpired(entry) {
+		c.removeElementLocked(ele, entry)
+		c.stats.expirations.Add(1)
+		return value, false
+	}
 	entry.visited = true // Mark this entry as visited
 	value = entry.value
-	return value, nil
+	return value, true
+}
+
+// recordHit increments the hit counter and, if onHit is registered, stages
+// key to be notified once the caller releases c.mu.
+func (c *Cache[K, V]) recordHit(key K) {
+	c.stats.hits.Add(1)
+	if c.onHit != nil {
+		c.hitKeys = append(c.hitKeys, key)
+	}
+}
+
+// recordMiss increments the miss counter and, if onMiss is registered,
+// stages key to be notified once the caller releases c.mu.
+func (c *Cache[K, V]) recordMiss(key K) {
+	c.stats.misses.Add(1)
+	if c.onMiss != nil {
+		c.missKeys = append(c.missKeys, key)
+	}
+}
+
+// removeElementLocked removes ele from the list and the key map, staging
+// the entry in the eviction buffer. It must be called with c.mu held.
+func (c *Cache[K, V]) removeElementLocked(ele *list.Element, entry *nodeEntry[K, V]) {
+	if c.hand == ele {
+		c.hand = ele.Prev()
+	}
+	c.q.Remove(ele)
+	delete(c.keysMap, entry.key)
+	c.size--
+	c.addEvicted(entry.key, entry.value)
 }
 
 // Keys returns a slice of all keys currently in the cache
 func (c *Cache[K, V]) Keys() []K {
+	c.mu.Lock()
+	defer c.mu.Unlock()
 	var keys []K
-	for k := range c.keysMap {
+	for k, ele := range c.keysMap {
+		entry := ele.Value.(*nodeEntry[K, V])
+		if isExpired(entry) {
+			continue
+		}
 		keys = append(keys, k)
 	}
 	return keys
@@ -97,9 +252,14 @@ func (c *Cache[K, V]) Keys() []K {
 
 // Items returns a slice of all key-value pairs (Item) currently in the cache
 func (c *Cache[K, V]) Items() []Item[K, V] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
 	var items []Item[K, V]
 	for k, ele := range c.keysMap {
 		entry := ele.Value.(*nodeEntry[K, V])
+		if isExpired(entry) {
+			continue
+		}
 		items = append(items, Item[K, V]{Key: k, Value: entry.value})
 	}
 	return items
@@ -107,51 +267,93 @@ func (c *Cache[K, V]) Items() []Item[K, V] {
 
 // Print outputs the current cache state, showing each key's value and visited status
 func (c *Cache[K, V]) Print() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
 	for curr := c.q.Front(); curr != nil; curr = curr.Next() {
 		ele := curr.Value.(*nodeEntry[K, V])
-		fmt.Printf("%s: %v\t", ele.value, ele.visited)
+		fmt.Printf("%v: %v\t", ele.value, ele.visited)
+	}
+	fmt.Println()
+}
+
+// String implements fmt.Stringer, rendering the same value/visited-bit
+// listing as Print as a single string.
+func (c *Cache[K, V]) String() string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var b strings.Builder
+	for curr := c.q.Front(); curr != nil; curr = curr.Next() {
+		entry := curr.Value.(*nodeEntry[K, V])
+		fmt.Fprintf(&b, "%v: %v\t", entry.value, entry.visited)
 	}
-	fmt.Println("\n")
+	return b.String()
 }
 
-// Clear resets the cache to its initial empty state
+// Clear resets the cache to its initial empty state, firing the eviction
+// callback for every entry that was present.
 func (c *Cache[K, V]) Clear() {
+	c.mu.Lock()
+	for curr := c.q.Front(); curr != nil; curr = curr.Next() {
+		entry := curr.Value.(*nodeEntry[K, V])
+		c.addEvicted(entry.key, entry.value)
+	}
+	keys, values := c.takeEvicted() // must run before init(), which resets the eviction buffers
 	c.init()
+	c.mu.Unlock()
+	c.notifyEvicted(keys, values)
 }
 
 // Delete removes a key-value pair from the cache and returns the value, or an error if not found
 func (c *Cache[K, V]) Delete(key K) (V, error) {
+	c.mu.Lock()
 	var value V
 	ele, ok := c.keysMap[key]
 	if !ok {
+		c.mu.Unlock()
 		return value, ErrKeyNotFound
 	}
-	delete(c.keysMap, key)
-	entry := c.q.Remove(ele).(*nodeEntry[K, V])
+	entry := ele.Value.(*nodeEntry[K, V])
 	value = entry.value
+	c.removeElementLocked(ele, entry) // keeps c.hand valid if it pointed at ele
+	keys, values := c.takeEvicted()
+	c.mu.Unlock()
+	c.notifyEvicted(keys, values)
 	return value, nil
 }
 
 // Evict removes the first unvisited entry to the left of hand from the cache and returns the evicted key
 func (c *Cache[K, V]) Evict() (K, error) {
-	return c.evict()
+	c.mu.Lock()
+	key, err := c.evict()
+	keys, values := c.takeEvicted()
+	c.mu.Unlock()
+	c.notifyEvicted(keys, values)
+	return key, err
 }
 
 // Contains checks whether the cache contains a given key
 func (c *Cache[K, V]) Contains(key K) bool {
-	_, ok := c.keysMap[key]
-	return ok
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	ele, ok := c.keysMap[key]
+	if !ok {
+		return false
+	}
+	entry := ele.Value.(*nodeEntry[K, V])
+	return !isExpired(entry)
 }
 
 // Resize changes the capacity of the cache, evicting items if necessary
 func (c *Cache[K, V]) Resize(newCapacity int64) []K {
+	c.mu.Lock()
 	var evictedKeys []K
 	if newCapacity >= c.capacity {
 		c.capacity = newCapacity
+		c.mu.Unlock()
 		return evictedKeys // No need to evict if new capacity is greater than or equal to current capacity
 	}
 	// Evict items if the new capacity is smaller
-	keysToEvictCount := c.Size() - newCapacity
+	keysToEvictCount := c.size - newCapacity
 	for keysToEvictCount > 0 {
 		if key, err := c.evict(); err == nil {
 			evictedKeys = append(evictedKeys, key)
@@ -159,6 +361,9 @@ func (c *Cache[K, V]) Resize(newCapacity int64) []K {
 		keysToEvictCount--
 	}
 	c.capacity = newCapacity
+	keys, values := c.takeEvicted()
+	c.mu.Unlock()
+	c.notifyEvicted(keys, values)
 	return evictedKeys
 }
 
@@ -168,9 +373,14 @@ func (c *Cache[K, V]) init() {
 	c.q = list.New()
 	c.keysMap = make(keyNodeMap[K, *list.Element], c.capacity)
 	c.hand = nil
+	c.evictedKeys = make([]K, 0, DefaultEvictedBufferSize)
+	c.evictedValues = make([]V, 0, DefaultEvictedBufferSize)
 }
 
-// evict removes the first unvisited entry to the left of hand from the cache, returns the evicted key
+// evict removes the first unvisited entry to the left of hand from the
+// cache (or an expired entry, visited or not, if the hand reaches one
+// first), returns the evicted key, and stages the removed entry in the
+// eviction buffer. It must be called with c.mu held.
 func (c *Cache[K, V]) evict() (K, error) {
 	var evictedKey K
 	if c.size == 0 {
@@ -180,23 +390,70 @@ func (c *Cache[K, V]) evict() (K, error) {
 	if curr == nil {
 		curr = c.q.Back() // Start from the back if no hand set
 	}
-	// Traverse to find an unvisited node to evict
+	// Traverse to find an unvisited (or expired) node to evict
+	var sweepLength int64
 	for {
 		entry := curr.Value.(*nodeEntry[K, V])
+		if isExpired(entry) {
+			break // Expired entries are dropped immediately, without consuming a visited-bit reset
+		}
 		if !entry.visited {
 			break // Found an unvisited entry to evict
 		}
 		entry.visited = false // Mark the entry as not visited
+		sweepLength++
 		curr = curr.Prev()
 		if curr == nil {
 			curr = c.q.Back() // Loop around if we've reached the beginning
 		}
 	}
+	c.stats.handSweepLength.Store(sweepLength)
 	c.hand = curr.Prev() // Update hand for next eviction
 	c.q.Remove(curr)
 	c.size--
 	entry := curr.Value.(*nodeEntry[K, V])
 	delete(c.keysMap, entry.key)
 	evictedKey = entry.key
+	c.stats.evictions.Add(1)
+	c.addEvicted(entry.key, entry.value)
 	return evictedKey, nil
 }
+
+// isExpired reports whether entry has a TTL set and it has passed.
+func isExpired[K comparable, V Value](entry *nodeEntry[K, V]) bool {
+	return !entry.expireAt.IsZero() && time.Now().After(entry.expireAt)
+}
+
+// addEvicted stages a removed entry in the eviction buffer. It must be
+// called with c.mu held.
+func (c *Cache[K, V]) addEvicted(key K, value V) {
+	if c.onEvicted == nil {
+		return
+	}
+	c.evictedKeys = append(c.evictedKeys, key)
+	c.evictedValues = append(c.evictedValues, value)
+}
+
+// takeEvicted returns the currently buffered evicted entries and resets the
+// buffer. It must be called with c.mu held.
+func (c *Cache[K, V]) takeEvicted() ([]K, []V) {
+	if len(c.evictedKeys) == 0 {
+		return nil, nil
+	}
+	keys, values := c.evictedKeys, c.evictedValues
+	c.evictedKeys = make([]K, 0, DefaultEvictedBufferSize)
+	c.evictedValues = make([]V, 0, DefaultEvictedBufferSize)
+	return keys, values
+}
+
+// notifyEvicted invokes the onEvicted callback for each buffered entry. It
+// must be called without c.mu held so that user code never runs under the
+// cache's lock.
+func (c *Cache[K, V]) notifyEvicted(keys []K, values []V) {
+	if c.onEvicted == nil {
+		return
+	}
+	for i := range keys {
+		c.onEvicted(keys[i], values[i])
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"cmp"
 	"fmt"
 	"slices"
+	"sync"
 	"testing"
 )
 
@@ -173,7 +174,7 @@ func TestCache_Items(t *testing.T) {
 	}
 	assertEqualSlice(t, expectedKeys, actualKeys, "")
 	assertEqualSlice(t, expectedValues, actualValues, "")
-	cache.String()
+	t.Log(cache.String())
 }
 
 func TestCache_Clear(t *testing.T) {
@@ -219,6 +220,99 @@ func TestCache_Delete(t *testing.T) {
 	}
 }
 
+func TestCache_DeleteKeepsHandConsistent(t *testing.T) {
+	cache := NewCache[int, int](5)
+	for i := 0; i < 5; i++ {
+		cache.Set(i, i)
+	}
+	// Move the hand off its initial nil position.
+	cache.Evict()
+	if cache.hand == nil {
+		t.Fatal("expected a non-nil hand after the first eviction")
+	}
+
+	// Deleting the key the hand currently points at must not leave the
+	// hand pointing at an unlinked list node.
+	handEntry := cache.hand.Value.(*nodeEntry[int, int])
+	if _, err := cache.Delete(handEntry.key); err != nil {
+		t.Fatalf("unexpected error deleting hand key: %v", err)
+	}
+
+	cache.Set(100, 100)
+	cache.Set(101, 101)
+
+	assertEqual(t, cache.Size(), int64(len(cache.Keys())), "Size should match the number of live keys")
+	if cache.Size() > cache.Capacity() {
+		t.Errorf("cache grew past capacity: size=%d capacity=%d", cache.Size(), cache.Capacity())
+	}
+}
+
+func TestCache_OnEvictedFiresForEveryRemovalPath(t *testing.T) {
+	var evicted []string
+	cache := NewCacheWithEvict[string, string](2, func(k, v string) {
+		evicted = append(evicted, k)
+	})
+
+	// Set overflow.
+	cache.Set("a", "A")
+	cache.Set("b", "B")
+	cache.Set("c", "C") // evicts "a"
+	assertEqualSlice(t, []string{"a"}, evicted, "after Set overflow")
+	cache.Clear()
+
+	// Evict.
+	evicted = nil
+	cache.Set("a", "A")
+	cache.Set("b", "B")
+	cache.Evict()
+	assertEqual(t, 1, len(evicted), "after Evict")
+	cache.Clear()
+
+	// Delete.
+	evicted = nil
+	cache.Set("d", "D")
+	cache.Delete("d")
+	assertEqualSlice(t, []string{"d"}, evicted, "after Delete")
+	cache.Clear()
+
+	// Resize shrinkage.
+	evicted = nil
+	cache.Set("e", "E")
+	cache.Set("f", "F")
+	cache.Resize(1)
+	assertEqual(t, 1, len(evicted), "after Resize shrinkage")
+	cache.Resize(2)
+
+	// Clear.
+	evicted = nil
+	cache.Clear()
+	assertEqual(t, 1, len(evicted), "after Clear")
+}
+
+func TestCache_ConcurrentSetGet(t *testing.T) {
+	cache := NewCache[int, int](64)
+	var wg sync.WaitGroup
+	const goroutines = 8
+	const perGoroutine = 200
+
+	for g := 0; g < goroutines; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < perGoroutine; i++ {
+				key := g*perGoroutine + i
+				cache.Set(key, key)
+				cache.Get(key)
+			}
+		}(g)
+	}
+	wg.Wait()
+
+	if cache.Size() > cache.Capacity() {
+		t.Errorf("cache grew past capacity under concurrent use: size=%d capacity=%d", cache.Size(), cache.Capacity())
+	}
+}
+
 func TestCache_Contains(t *testing.T) {
 	cache := NewCache[string, string](5)
 
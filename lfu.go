@@ -0,0 +1,202 @@
+package sieve
+
+import "container/list"
+
+// lfuFreqNode is a bucket holding every entry currently at the same access
+// frequency, part of the O(1) LFU structure described by Shah et al.
+type lfuFreqNode[K comparable, V Value] struct {
+	freq  int64
+	items *list.List // list of *lfuItem[K,V], most recently touched at front
+}
+
+// lfuItem stores cache data together with a back-reference to the
+// frequency bucket (and position within it) it currently lives in.
+type lfuItem[K comparable, V Value] struct {
+	key      K
+	value    V
+	freqNode *list.Element // element in LFUCache.freqs holding this item's *lfuFreqNode
+	itemElem *list.Element // this item's own element within freqNode.items
+}
+
+// LFUCache is a Policy[K,V] implementation using least-frequently-used
+// eviction, with O(1) Set/Get/Evict via a doubly linked list of
+// frequency buckets (Shah's algorithm) - see the Policy doc comment for
+// the locking and Set-on-existing-key guarantees shared across
+// implementations.
+type LFUCache[K Key, V Value] struct {
+	capacity int64
+	size     int64
+	freqs    *list.List // list of *lfuFreqNode[K,V], ascending by freq
+	items    map[K]*lfuItem[K, V]
+}
+
+// NewLFUCache initializes a new LFU cache with the given capacity.
+func NewLFUCache[K Key, V Value](capacity int64) *LFUCache[K, V] {
+	c := &LFUCache[K, V]{capacity: capacity}
+	c.init()
+	return c
+}
+
+func (c *LFUCache[K, V]) init() {
+	c.size = 0
+	c.freqs = list.New()
+	c.items = make(map[K]*lfuItem[K, V], c.capacity)
+}
+
+// Size returns the current number of items in the cache.
+func (c *LFUCache[K, V]) Size() int64 {
+	return c.size
+}
+
+// Capacity returns the maximum capacity of the cache.
+func (c *LFUCache[K, V]) Capacity() int64 {
+	return c.capacity
+}
+
+// Set adds a key-value pair to the cache at frequency 1, evicting the
+// least frequently used entry if necessary. Per the Policy contract,
+// re-Setting an already-present key is a no-op on the value - it only
+// bumps its frequency.
+func (c *LFUCache[K, V]) Set(key K, value V) {
+	if c.capacity <= 0 {
+		return
+	}
+	if it, ok := c.items[key]; ok {
+		c.touch(it)
+		return
+	}
+	if c.size == c.capacity {
+		c.evict()
+	}
+
+	front := c.freqs.Front()
+	if front == nil || front.Value.(*lfuFreqNode[K, V]).freq != 1 {
+		front = c.freqs.PushFront(&lfuFreqNode[K, V]{freq: 1, items: list.New()})
+	}
+	fn := front.Value.(*lfuFreqNode[K, V])
+	it := &lfuItem[K, V]{key: key, value: value, freqNode: front}
+	it.itemElem = fn.items.PushFront(it)
+	c.items[key] = it
+	c.size++
+}
+
+// Get retrieves the value for a given key and bumps its frequency,
+// returning an error if the key is not found.
+func (c *LFUCache[K, V]) Get(key K) (V, error) {
+	var value V
+	it, ok := c.items[key]
+	if !ok {
+		return value, ErrKeyNotFound
+	}
+	c.touch(it)
+	return it.value, nil
+}
+
+// touch moves it from its current frequency bucket to the next one,
+// creating or reusing buckets as needed.
+func (c *LFUCache[K, V]) touch(it *lfuItem[K, V]) {
+	curr := it.freqNode
+	fn := curr.Value.(*lfuFreqNode[K, V])
+	fn.items.Remove(it.itemElem)
+
+	next := curr.Next()
+	if next == nil || next.Value.(*lfuFreqNode[K, V]).freq != fn.freq+1 {
+		next = c.freqs.InsertAfter(&lfuFreqNode[K, V]{freq: fn.freq + 1, items: list.New()}, curr)
+	}
+	nfn := next.Value.(*lfuFreqNode[K, V])
+	it.itemElem = nfn.items.PushFront(it)
+	it.freqNode = next
+
+	if fn.items.Len() == 0 {
+		c.freqs.Remove(curr)
+	}
+}
+
+// Delete removes a key-value pair from the cache and returns the value, or
+// an error if not found.
+func (c *LFUCache[K, V]) Delete(key K) (V, error) {
+	var value V
+	it, ok := c.items[key]
+	if !ok {
+		return value, ErrKeyNotFound
+	}
+	value = it.value
+	c.removeItem(it)
+	return value, nil
+}
+
+func (c *LFUCache[K, V]) removeItem(it *lfuItem[K, V]) {
+	fn := it.freqNode.Value.(*lfuFreqNode[K, V])
+	fn.items.Remove(it.itemElem)
+	if fn.items.Len() == 0 {
+		c.freqs.Remove(it.freqNode)
+	}
+	delete(c.items, it.key)
+	c.size--
+}
+
+// Evict removes the least frequently used entry (the oldest entry within
+// the lowest frequency bucket) and returns its key.
+func (c *LFUCache[K, V]) Evict() (K, error) {
+	return c.evict()
+}
+
+func (c *LFUCache[K, V]) evict() (K, error) {
+	var key K
+	front := c.freqs.Front()
+	if front == nil {
+		return key, ErrEmptyCache
+	}
+	fn := front.Value.(*lfuFreqNode[K, V])
+	it := fn.items.Back().Value.(*lfuItem[K, V])
+	key = it.key
+	c.removeItem(it)
+	return key, nil
+}
+
+// Contains checks whether the cache contains a given key.
+func (c *LFUCache[K, V]) Contains(key K) bool {
+	_, ok := c.items[key]
+	return ok
+}
+
+// Resize changes the capacity of the cache, evicting items if necessary.
+func (c *LFUCache[K, V]) Resize(newCapacity int64) []K {
+	var evictedKeys []K
+	if newCapacity >= c.capacity {
+		c.capacity = newCapacity
+		return evictedKeys
+	}
+	keysToEvictCount := c.size - newCapacity
+	for keysToEvictCount > 0 {
+		if key, err := c.evict(); err == nil {
+			evictedKeys = append(evictedKeys, key)
+		}
+		keysToEvictCount--
+	}
+	c.capacity = newCapacity
+	return evictedKeys
+}
+
+// Keys returns a slice of all keys currently in the cache.
+func (c *LFUCache[K, V]) Keys() []K {
+	var keys []K
+	for k := range c.items {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+// Items returns a slice of all key-value pairs currently in the cache.
+func (c *LFUCache[K, V]) Items() []Item[K, V] {
+	var items []Item[K, V]
+	for k, it := range c.items {
+		items = append(items, Item[K, V]{Key: k, Value: it.value})
+	}
+	return items
+}
+
+// Clear resets the cache to its initial empty state.
+func (c *LFUCache[K, V]) Clear() {
+	c.init()
+}
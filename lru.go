@@ -0,0 +1,153 @@
+package sieve
+
+import "container/list"
+
+// lruEntry stores cache data (key-value pair) in the LRU list.
+type lruEntry[K comparable, V Value] struct {
+	key   K
+	value V
+}
+
+// LRUCache is a Policy[K,V] implementation using classic
+// least-recently-used eviction - see the Policy doc comment for the
+// locking and Set-on-existing-key guarantees shared across implementations.
+type LRUCache[K Key, V Value] struct {
+	capacity int64
+	size     int64
+	q        *list.List
+	keysMap  map[K]*list.Element
+}
+
+// NewLRUCache initializes a new LRU cache with the given capacity.
+func NewLRUCache[K Key, V Value](capacity int64) *LRUCache[K, V] {
+	c := &LRUCache[K, V]{capacity: capacity}
+	c.init()
+	return c
+}
+
+func (c *LRUCache[K, V]) init() {
+	c.size = 0
+	c.q = list.New()
+	c.keysMap = make(map[K]*list.Element, c.capacity)
+}
+
+// Size returns the current number of items in the cache.
+func (c *LRUCache[K, V]) Size() int64 {
+	return c.size
+}
+
+// Capacity returns the maximum capacity of the cache.
+func (c *LRUCache[K, V]) Capacity() int64 {
+	return c.capacity
+}
+
+// Set adds a key-value pair to the cache, evicting the least recently used
+// entry if necessary, and marks the key as most recently used. Per the
+// Policy contract, re-Setting an already-present key is a no-op on the
+// value - it only refreshes recency.
+func (c *LRUCache[K, V]) Set(key K, value V) {
+	if c.capacity <= 0 {
+		return
+	}
+	if ele, ok := c.keysMap[key]; ok {
+		c.q.MoveToFront(ele)
+		return
+	}
+	if c.size == c.capacity {
+		c.evict()
+	}
+	ele := c.q.PushFront(&lruEntry[K, V]{key: key, value: value})
+	c.keysMap[key] = ele
+	c.size++
+}
+
+// Get retrieves the value for a given key and marks it as most recently
+// used, returning an error if the key is not found.
+func (c *LRUCache[K, V]) Get(key K) (V, error) {
+	var value V
+	ele, ok := c.keysMap[key]
+	if !ok {
+		return value, ErrKeyNotFound
+	}
+	c.q.MoveToFront(ele)
+	return ele.Value.(*lruEntry[K, V]).value, nil
+}
+
+// Delete removes a key-value pair from the cache and returns the value, or
+// an error if not found.
+func (c *LRUCache[K, V]) Delete(key K) (V, error) {
+	var value V
+	ele, ok := c.keysMap[key]
+	if !ok {
+		return value, ErrKeyNotFound
+	}
+	delete(c.keysMap, key)
+	entry := c.q.Remove(ele).(*lruEntry[K, V])
+	c.size--
+	return entry.value, nil
+}
+
+// Evict removes the least recently used entry from the cache and returns
+// its key.
+func (c *LRUCache[K, V]) Evict() (K, error) {
+	return c.evict()
+}
+
+func (c *LRUCache[K, V]) evict() (K, error) {
+	var key K
+	if c.size == 0 {
+		return key, ErrEmptyCache
+	}
+	ele := c.q.Back()
+	entry := c.q.Remove(ele).(*lruEntry[K, V])
+	delete(c.keysMap, entry.key)
+	c.size--
+	return entry.key, nil
+}
+
+// Contains checks whether the cache contains a given key.
+func (c *LRUCache[K, V]) Contains(key K) bool {
+	_, ok := c.keysMap[key]
+	return ok
+}
+
+// Resize changes the capacity of the cache, evicting items if necessary.
+func (c *LRUCache[K, V]) Resize(newCapacity int64) []K {
+	var evictedKeys []K
+	if newCapacity >= c.capacity {
+		c.capacity = newCapacity
+		return evictedKeys
+	}
+	keysToEvictCount := c.size - newCapacity
+	for keysToEvictCount > 0 {
+		if key, err := c.evict(); err == nil {
+			evictedKeys = append(evictedKeys, key)
+		}
+		keysToEvictCount--
+	}
+	c.capacity = newCapacity
+	return evictedKeys
+}
+
+// Keys returns a slice of all keys currently in the cache.
+func (c *LRUCache[K, V]) Keys() []K {
+	var keys []K
+	for k := range c.keysMap {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+// Items returns a slice of all key-value pairs currently in the cache.
+func (c *LRUCache[K, V]) Items() []Item[K, V] {
+	var items []Item[K, V]
+	for k, ele := range c.keysMap {
+		items = append(items, Item[K, V]{Key: k, Value: ele.Value.(*lruEntry[K, V]).value})
+	}
+	return items
+}
+
+// Clear resets the cache to its initial empty state.
+func (c *LRUCache[K, V]) Clear() {
+	c.init()
+}
@@ -0,0 +1,148 @@
+package sieve
+
+import (
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+)
+
+// snapshotMagic identifies a sieve cache snapshot stream.
+var snapshotMagic = [4]byte{'S', 'I', 'E', 'V'}
+
+// snapshotVersion is bumped whenever the on-disk snapshot format changes.
+const snapshotVersion = 1
+
+// ErrInvalidSnapshot is returned by Restore when the stream does not start
+// with the expected magic header.
+var ErrInvalidSnapshot = errors.New("sieve: invalid snapshot header")
+
+// Codec encodes and decodes the values written by Snapshot and read back
+// by Restore, so callers can swap the default gob encoding for JSON,
+// MessagePack, or any other format their keys and values round-trip
+// through.
+type Codec interface {
+	Encode(w io.Writer, v any) error
+	Decode(r io.Reader, v any) error
+}
+
+// GobCodec is the default Codec, using encoding/gob.
+type GobCodec struct{}
+
+// Encode gob-encodes v to w.
+func (GobCodec) Encode(w io.Writer, v any) error {
+	return gob.NewEncoder(w).Encode(v)
+}
+
+// Decode gob-decodes v from r.
+func (GobCodec) Decode(r io.Reader, v any) error {
+	return gob.NewDecoder(r).Decode(v)
+}
+
+// snapshotEntry is one cache entry as written to a snapshot. Capturing the
+// visited bit and expiry, not just the key/value, is what distinguishes a
+// snapshot from a plain dump: it lets Restore reproduce which entries
+// would survive the next SIEVE eviction sweep.
+type snapshotEntry[K comparable, V Value] struct {
+	Key      K
+	Value    V
+	Visited  bool
+	ExpireAt time.Time
+}
+
+// snapshotHeader captures the cache-wide state needed to reproduce the
+// SIEVE hand position on restore.
+type snapshotHeader struct {
+	Capacity   int64
+	DefaultTTL time.Duration
+	HandOffset int // index into the entry list (front=0) the hand pointed at, or -1
+}
+
+// Snapshot serializes the cache's capacity, list order, visited bits, and
+// hand position to w using gob, so a long-running process can warm up from
+// it on restart instead of cold-starting.
+func (c *Cache[K, V]) Snapshot(w io.Writer) error {
+	return c.SnapshotWithCodec(w, GobCodec{})
+}
+
+// SnapshotWithCodec is like Snapshot but uses the given Codec instead of gob.
+func (c *Cache[K, V]) SnapshotWithCodec(w io.Writer, codec Codec) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, err := w.Write(snapshotMagic[:]); err != nil {
+		return err
+	}
+	if err := codec.Encode(w, uint8(snapshotVersion)); err != nil {
+		return err
+	}
+
+	handOffset := -1
+	entries := make([]snapshotEntry[K, V], 0, c.size)
+	for curr := c.q.Front(); curr != nil; curr = curr.Next() {
+		if curr == c.hand {
+			handOffset = len(entries)
+		}
+		entry := curr.Value.(*nodeEntry[K, V])
+		entries = append(entries, snapshotEntry[K, V]{
+			Key:      entry.key,
+			Value:    entry.value,
+			Visited:  entry.visited,
+			ExpireAt: entry.expireAt,
+		})
+	}
+
+	header := snapshotHeader{Capacity: c.capacity, DefaultTTL: c.defaultTTL, HandOffset: handOffset}
+	if err := codec.Encode(w, header); err != nil {
+		return err
+	}
+	return codec.Encode(w, entries)
+}
+
+// Restore reads a snapshot written by Snapshot and rebuilds the cache it
+// describes, including list order, visited bits, and hand position.
+func Restore[K Key, V Value](r io.Reader) (*Cache[K, V], error) {
+	return RestoreWithCodec[K, V](r, GobCodec{})
+}
+
+// RestoreWithCodec is like Restore but uses the given Codec instead of gob.
+func RestoreWithCodec[K Key, V Value](r io.Reader, codec Codec) (*Cache[K, V], error) {
+	var magic [4]byte
+	if _, err := io.ReadFull(r, magic[:]); err != nil {
+		return nil, err
+	}
+	if magic != snapshotMagic {
+		return nil, ErrInvalidSnapshot
+	}
+
+	var version uint8
+	if err := codec.Decode(r, &version); err != nil {
+		return nil, err
+	}
+	if version != snapshotVersion {
+		return nil, fmt.Errorf("sieve: unsupported snapshot version %d", version)
+	}
+
+	var header snapshotHeader
+	if err := codec.Decode(r, &header); err != nil {
+		return nil, err
+	}
+	var entries []snapshotEntry[K, V]
+	if err := codec.Decode(r, &entries); err != nil {
+		return nil, err
+	}
+
+	cache := NewCache[K, V](header.Capacity)
+	cache.defaultTTL = header.DefaultTTL
+	for i, e := range entries {
+		node := &nodeEntry[K, V]{key: e.Key, value: e.Value, visited: e.Visited, expireAt: e.ExpireAt}
+		element := cache.q.PushBack(node)
+		cache.keysMap[e.Key] = element
+		cache.size++
+		if i == header.HandOffset {
+			cache.hand = element
+		}
+	}
+	return cache, nil
+}
@@ -0,0 +1,63 @@
+package sieve
+
+import (
+	"bytes"
+	"testing"
+	"time"
+)
+
+func TestCache_SnapshotRestoreRoundTrip(t *testing.T) {
+	cache := NewCache[string, string](3)
+	cache.Set("a", "A")
+	cache.Set("b", "B")
+	cache.Set("c", "C")
+	cache.Get("a") // mark "a" visited so the restored cache reproduces it
+
+	var buf bytes.Buffer
+	if err := cache.Snapshot(&buf); err != nil {
+		t.Fatalf("Snapshot failed: %v", err)
+	}
+
+	restored, err := Restore[string, string](&buf)
+	if err != nil {
+		t.Fatalf("Restore failed: %v", err)
+	}
+
+	assertEqual(t, cache.Capacity(), restored.Capacity(), "")
+	assertEqual(t, cache.Size(), restored.Size(), "")
+	assertEqualSlice(t, cache.Keys(), restored.Keys(), "")
+
+	for _, item := range cache.Items() {
+		value, err := restored.Get(item.Key)
+		assertErrorNil(t, err)
+		assertEqual(t, item.Value, value, "for key "+item.Key)
+	}
+}
+
+func TestCache_SnapshotRestorePreservesTTL(t *testing.T) {
+	cache := NewCache[string, string](3)
+	cache.SetWithTTL("a", "A", time.Hour)
+	cache.Set("b", "B") // never expires
+
+	var buf bytes.Buffer
+	if err := cache.Snapshot(&buf); err != nil {
+		t.Fatalf("Snapshot failed: %v", err)
+	}
+
+	restored, err := Restore[string, string](&buf)
+	if err != nil {
+		t.Fatalf("Restore failed: %v", err)
+	}
+
+	if _, err := restored.Get("a"); err != nil {
+		t.Fatalf("expected \"a\" to survive restore unexpired, got error: %v", err)
+	}
+	if _, err := restored.Get("b"); err != nil {
+		t.Fatalf("expected \"b\" to survive restore, got error: %v", err)
+	}
+}
+
+func TestCache_RestoreRejectsInvalidHeader(t *testing.T) {
+	_, err := Restore[string, string](bytes.NewReader([]byte("not a snapshot")))
+	assertErrorEqual(t, ErrInvalidSnapshot, err)
+}
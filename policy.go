@@ -0,0 +1,29 @@
+package sieve
+
+// Policy is the common interface implemented by every eviction strategy in
+// this package. Cache is the SIEVE implementation; NewLRUCache,
+// NewLFUCache, and NewARCCache provide drop-in alternatives for callers
+// migrating from other cache libraries without rewriting call sites. Every
+// implementation agrees that Set on an already-present key leaves its
+// value unchanged (it only affects eviction order); only Cache is safe for
+// concurrent use without external locking - LRUCache, LFUCache, and
+// ARCCache do no internal locking and need external synchronization if
+// shared across goroutines.
+type Policy[K Key, V Value] interface {
+	Set(key K, value V)
+	Get(key K) (V, error)
+	Delete(key K) (V, error)
+	Evict() (K, error)
+	Resize(newCapacity int64) []K
+	Keys() []K
+	Items() []Item[K, V]
+	Contains(key K) bool
+	Clear()
+}
+
+var (
+	_ Policy[string, string] = (*Cache[string, string])(nil)
+	_ Policy[string, string] = (*LRUCache[string, string])(nil)
+	_ Policy[string, string] = (*LFUCache[string, string])(nil)
+	_ Policy[string, string] = (*ARCCache[string, string])(nil)
+)
@@ -0,0 +1,107 @@
+package sieve
+
+import (
+	"math/rand"
+	"testing"
+)
+
+// zipfTrace generates a trace of n accesses over a keyspace of size
+// keyspace, skewed towards low keys following a Zipf distribution - a
+// stand-in for "hot key" production workloads.
+func zipfTrace(n, keyspace int) []int {
+	r := rand.New(rand.NewSource(1))
+	z := rand.NewZipf(r, 1.5, 1, uint64(keyspace-1))
+	trace := make([]int, n)
+	for i := range trace {
+		trace[i] = int(z.Uint64())
+	}
+	return trace
+}
+
+// scanTrace generates a trace that sweeps sequentially through a keyspace
+// much larger than any reasonable cache capacity, repeated rounds times -
+// the classic pathological case for recency-only eviction.
+func scanTrace(rounds, keyspace int) []int {
+	trace := make([]int, 0, rounds*keyspace)
+	for r := 0; r < rounds; r++ {
+		for k := 0; k < keyspace; k++ {
+			trace = append(trace, k)
+		}
+	}
+	return trace
+}
+
+// runHitRate replays trace against policy, reporting the fraction of
+// accesses that were already cached.
+func runHitRate(b *testing.B, policy Policy[int, int], trace []int) {
+	var hits int
+	for _, key := range trace {
+		if _, err := policy.Get(key); err == nil {
+			hits++
+		} else {
+			policy.Set(key, key)
+		}
+	}
+	b.ReportMetric(float64(hits)/float64(len(trace)), "hit-rate")
+}
+
+const (
+	benchCapacity = 100
+	benchKeyspace = 1000
+)
+
+func BenchmarkHitRate_Sieve_Zipf(b *testing.B) {
+	trace := zipfTrace(20000, benchKeyspace)
+	for i := 0; i < b.N; i++ {
+		runHitRate(b, NewCache[int, int](benchCapacity), trace)
+	}
+}
+
+func BenchmarkHitRate_LRU_Zipf(b *testing.B) {
+	trace := zipfTrace(20000, benchKeyspace)
+	for i := 0; i < b.N; i++ {
+		runHitRate(b, NewLRUCache[int, int](benchCapacity), trace)
+	}
+}
+
+func BenchmarkHitRate_LFU_Zipf(b *testing.B) {
+	trace := zipfTrace(20000, benchKeyspace)
+	for i := 0; i < b.N; i++ {
+		runHitRate(b, NewLFUCache[int, int](benchCapacity), trace)
+	}
+}
+
+func BenchmarkHitRate_ARC_Zipf(b *testing.B) {
+	trace := zipfTrace(20000, benchKeyspace)
+	for i := 0; i < b.N; i++ {
+		runHitRate(b, NewARCCache[int, int](benchCapacity), trace)
+	}
+}
+
+func BenchmarkHitRate_Sieve_Scan(b *testing.B) {
+	trace := scanTrace(5, benchKeyspace)
+	for i := 0; i < b.N; i++ {
+		runHitRate(b, NewCache[int, int](benchCapacity), trace)
+	}
+}
+
+func BenchmarkHitRate_LRU_Scan(b *testing.B) {
+	trace := scanTrace(5, benchKeyspace)
+	for i := 0; i < b.N; i++ {
+		runHitRate(b, NewLRUCache[int, int](benchCapacity), trace)
+	}
+}
+
+func BenchmarkHitRate_LFU_Scan(b *testing.B) {
+	trace := scanTrace(5, benchKeyspace)
+	for i := 0; i < b.N; i++ {
+		runHitRate(b, NewLFUCache[int, int](benchCapacity), trace)
+	}
+}
+
+func BenchmarkHitRate_ARC_Scan(b *testing.B) {
+	trace := scanTrace(5, benchKeyspace)
+	for i := 0; i < b.N; i++ {
+		runHitRate(b, NewARCCache[int, int](benchCapacity), trace)
+	}
+}
@@ -0,0 +1,24 @@
+package sieve
+
+import "testing"
+
+// TestPolicy_SetIsNoOpOnExistingKey verifies the guarantee documented on
+// Policy: re-Setting an already-present key must not change its value, for
+// every implementation, so callers can swap one for another without the
+// value silently changing underneath them.
+func TestPolicy_SetIsNoOpOnExistingKey(t *testing.T) {
+	policies := map[string]Policy[string, int]{
+		"Cache":    NewCache[string, int](5),
+		"LRUCache": NewLRUCache[string, int](5),
+		"LFUCache": NewLFUCache[string, int](5),
+		"ARCCache": NewARCCache[string, int](5),
+	}
+
+	for name, policy := range policies {
+		policy.Set("a", 1)
+		policy.Set("a", 2)
+		value, err := policy.Get("a")
+		assertErrorNil(t, err)
+		assertEqual(t, 1, value, name)
+	}
+}
@@ -0,0 +1,220 @@
+package sieve
+
+import (
+	"fmt"
+	"hash/fnv"
+	"reflect"
+	"unsafe"
+)
+
+// Hasher maps a key to a uint64 used to pick a shard in a ShardedCache.
+type Hasher[K Key] func(key K) uint64
+
+// ShardedCache partitions keys across a fixed number of shards, each an
+// independent Cache[K,V] with its own lock and SIEVE state, so lookups
+// scale across multiple cores on high-concurrency workloads.
+type ShardedCache[K Key, V Value] struct {
+	shards []*Cache[K, V]
+	mask   uint64
+	hasher Hasher[K]
+}
+
+// NewShardedCache initializes a sharded cache with capacity spread evenly
+// across shards shards (rounded up to the next power of two), using a
+// default Hasher for string, integer, and other comparable key types
+// (including fixed-size byte array keys such as [32]byte).
+func NewShardedCache[K Key, V Value](capacity int64, shards int) *ShardedCache[K, V] {
+	return NewShardedCacheWithHasher[K, V](capacity, shards, defaultHasher[K]())
+}
+
+// NewShardedCacheWithHasher is like NewShardedCache but accepts a custom
+// Hasher for distributing keys across shards.
+func NewShardedCacheWithHasher[K Key, V Value](capacity int64, shards int, hasher Hasher[K]) *ShardedCache[K, V] {
+	n := nextPowerOfTwo(shards)
+	sc := &ShardedCache[K, V]{
+		shards: make([]*Cache[K, V], n),
+		mask:   uint64(n - 1),
+		hasher: hasher,
+	}
+	for i, perShard := range distribute(capacity, n) {
+		sc.shards[i] = NewCache[K, V](perShard)
+	}
+	return sc
+}
+
+// shardFor returns the shard responsible for key.
+func (sc *ShardedCache[K, V]) shardFor(key K) *Cache[K, V] {
+	return sc.shards[sc.hasher(key)&sc.mask]
+}
+
+// Set adds a new key-value pair to the shard responsible for key.
+func (sc *ShardedCache[K, V]) Set(key K, value V) {
+	sc.shardFor(key).Set(key, value)
+}
+
+// Get retrieves the value for a given key from its shard.
+func (sc *ShardedCache[K, V]) Get(key K) (V, error) {
+	return sc.shardFor(key).Get(key)
+}
+
+// Delete removes a key-value pair from its shard.
+func (sc *ShardedCache[K, V]) Delete(key K) (V, error) {
+	return sc.shardFor(key).Delete(key)
+}
+
+// Contains checks whether any shard contains the given key.
+func (sc *ShardedCache[K, V]) Contains(key K) bool {
+	return sc.shardFor(key).Contains(key)
+}
+
+// Evict removes one entry from the first non-empty shard, in shard order,
+// so that eviction is deterministic across repeated runs.
+func (sc *ShardedCache[K, V]) Evict() (K, error) {
+	for _, shard := range sc.shards {
+		if shard.Size() > 0 {
+			return shard.Evict()
+		}
+	}
+	var key K
+	return key, ErrEmptyCache
+}
+
+// Size returns the total number of items held across all shards.
+func (sc *ShardedCache[K, V]) Size() int64 {
+	var total int64
+	for _, shard := range sc.shards {
+		total += shard.Size()
+	}
+	return total
+}
+
+// Capacity returns the total capacity across all shards.
+func (sc *ShardedCache[K, V]) Capacity() int64 {
+	var total int64
+	for _, shard := range sc.shards {
+		total += shard.Capacity()
+	}
+	return total
+}
+
+// Keys returns a slice of all keys currently held across all shards.
+func (sc *ShardedCache[K, V]) Keys() []K {
+	var keys []K
+	for _, shard := range sc.shards {
+		keys = append(keys, shard.Keys()...)
+	}
+	return keys
+}
+
+// Items returns a slice of all key-value pairs currently held across all shards.
+func (sc *ShardedCache[K, V]) Items() []Item[K, V] {
+	var items []Item[K, V]
+	for _, shard := range sc.shards {
+		items = append(items, shard.Items()...)
+	}
+	return items
+}
+
+// Clear resets every shard to its initial empty state.
+func (sc *ShardedCache[K, V]) Clear() {
+	for _, shard := range sc.shards {
+		shard.Clear()
+	}
+}
+
+// Resize changes the total capacity of the cache, redistributing it evenly
+// across the existing shards and evicting items from any shard that shrinks.
+func (sc *ShardedCache[K, V]) Resize(newCapacity int64) []K {
+	var evictedKeys []K
+	for i, perShard := range distribute(newCapacity, len(sc.shards)) {
+		evictedKeys = append(evictedKeys, sc.shards[i].Resize(perShard)...)
+	}
+	return evictedKeys
+}
+
+// distribute splits capacity as evenly as possible across n shards, so the
+// sum of the returned per-shard capacities always equals capacity exactly
+// (the first capacity%n shards get one extra) instead of rounding each
+// share up and silently handing out more total capacity than requested.
+func distribute(capacity int64, n int) []int64 {
+	base := capacity / int64(n)
+	remainder := capacity % int64(n)
+	perShard := make([]int64, n)
+	for i := range perShard {
+		perShard[i] = base
+		if int64(i) < remainder {
+			perShard[i]++
+		}
+	}
+	return perShard
+}
+
+// nextPowerOfTwo returns the smallest power of two greater than or equal to n.
+func nextPowerOfTwo(n int) int {
+	if n <= 1 {
+		return 1
+	}
+	p := 1
+	for p < n {
+		p <<= 1
+	}
+	return p
+}
+
+// defaultHasher returns a Hasher with sane defaults for string, integer,
+// and other comparable key types - including fixed-size byte array keys
+// (e.g. the [32]byte digests used by DNS caches), which are hashed byte by
+// byte rather than through their default formatting. The key's reflect.Type
+// is inspected once here, at Hasher construction, to find the array's
+// length; the returned closure then reinterprets the key's own bytes via
+// unsafe.Slice instead of paying for a reflect.Value on every call.
+func defaultHasher[K Key]() Hasher[K] {
+	var zero K
+	if t := reflect.TypeOf(zero); t != nil && t.Kind() == reflect.Array && t.Elem().Kind() == reflect.Uint8 {
+		n := t.Len()
+		return func(key K) uint64 {
+			buf := unsafe.Slice((*byte)(unsafe.Pointer(&key)), n)
+			return fnvHashBytes(buf)
+		}
+	}
+	return func(key K) uint64 {
+		switch k := any(key).(type) {
+		case string:
+			return fnvHash(k)
+		case int:
+			return uint64(k)
+		case int8:
+			return uint64(k)
+		case int16:
+			return uint64(k)
+		case int32:
+			return uint64(k)
+		case int64:
+			return uint64(k)
+		case uint:
+			return uint64(k)
+		case uint8:
+			return uint64(k)
+		case uint16:
+			return uint64(k)
+		case uint32:
+			return uint64(k)
+		case uint64:
+			return k
+		default:
+			return fnvHash(fmt.Sprintf("%v", k))
+		}
+	}
+}
+
+// fnvHash computes the FNV-1a hash of s.
+func fnvHash(s string) uint64 {
+	return fnvHashBytes([]byte(s))
+}
+
+// fnvHashBytes computes the FNV-1a hash of b.
+func fnvHashBytes(b []byte) uint64 {
+	h := fnv.New64a()
+	h.Write(b)
+	return h.Sum64()
+}
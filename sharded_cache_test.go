@@ -0,0 +1,66 @@
+package sieve
+
+import "testing"
+
+// TestShardedCache_CapacityMatchesRequested verifies that total capacity
+// across all shards exactly equals what the caller asked for, both at
+// construction and after Resize, instead of rounding each shard's share up
+// and silently handing out more capacity than requested.
+func TestShardedCache_CapacityMatchesRequested(t *testing.T) {
+	sc := NewShardedCache[int, int](8, 2)
+	assertEqual(t, int64(8), sc.Capacity(), "initial capacity")
+
+	sc.Resize(20)
+	assertEqual(t, int64(20), sc.Capacity(), "capacity after growing resize")
+
+	sc.Resize(3)
+	assertEqual(t, int64(3), sc.Capacity(), "capacity after shrinking resize to a total that does not divide evenly across shards")
+}
+
+// TestDistribute verifies that per-shard shares always sum back to the
+// requested capacity exactly, for capacities both smaller and larger than
+// the shard count.
+func TestDistribute(t *testing.T) {
+	for _, tc := range []struct {
+		capacity int64
+		shards   int
+	}{
+		{0, 4}, {1, 4}, {3, 4}, {8, 2}, {17, 4}, {100, 8},
+	} {
+		perShard := distribute(tc.capacity, tc.shards)
+		assertEqual(t, tc.shards, len(perShard), "number of shards")
+		var total int64
+		for _, c := range perShard {
+			total += c
+		}
+		assertEqual(t, tc.capacity, total, "sum of per-shard capacities")
+	}
+}
+
+// TestDefaultHasher_ByteArrayKey verifies that [N]byte keys (the DNS-digest
+// use case) hash deterministically, that distinct keys hash differently, and
+// that a ShardedCache keyed on them stores and returns their values.
+func TestDefaultHasher_ByteArrayKey(t *testing.T) {
+	hasher := defaultHasher[[32]byte]()
+
+	var a, b [32]byte
+	a[0], a[31] = 1, 2
+	b[0], b[31] = 3, 4
+
+	h1 := hasher(a)
+	h2 := hasher(a)
+	assertEqual(t, h1, h2, "hashing the same key twice must be deterministic")
+	if h1 == hasher(b) {
+		t.Fatalf("expected different [32]byte keys to hash differently, got equal hashes %d", h1)
+	}
+
+	sc := NewShardedCache[[32]byte, string](16, 4)
+	sc.Set(a, "a")
+	sc.Set(b, "b")
+	va, err := sc.Get(a)
+	assertErrorNil(t, err)
+	assertEqual(t, "a", va, "value for key a")
+	vb, err := sc.Get(b)
+	assertErrorNil(t, err)
+	assertEqual(t, "b", vb, "value for key b")
+}
@@ -0,0 +1,79 @@
+package sieve
+
+import (
+	"fmt"
+	"sync/atomic"
+)
+
+// Stats is a point-in-time snapshot of a Cache's activity counters.
+type Stats struct {
+	Hits            int64 // Get calls that found a live entry
+	Misses          int64 // Get calls that found no entry, or a lazily-expired one
+	Evictions       int64 // Entries removed by the SIEVE hand to make room
+	Insertions      int64 // Entries added via Set/SetWithTTL/MSet
+	Expirations     int64 // Entries removed because their TTL had passed
+	HandSweepLength int64 // Number of visited-bit resets performed by the most recent eviction
+}
+
+// statsCounters holds the atomic counters backing Stats, so they can be
+// read concurrently with the cache's locking variant.
+type statsCounters struct {
+	hits            atomic.Int64
+	misses          atomic.Int64
+	evictions       atomic.Int64
+	insertions      atomic.Int64
+	expirations     atomic.Int64
+	handSweepLength atomic.Int64
+}
+
+// Stats returns a snapshot of the cache's activity counters.
+func (c *Cache[K, V]) Stats() Stats {
+	return Stats{
+		Hits:            c.stats.hits.Load(),
+		Misses:          c.stats.misses.Load(),
+		Evictions:       c.stats.evictions.Load(),
+		Insertions:      c.stats.insertions.Load(),
+		Expirations:     c.stats.expirations.Load(),
+		HandSweepLength: c.stats.handSweepLength.Load(),
+	}
+}
+
+// OnHit registers f to be called, outside of the cache's lock, for every
+// key found by Get (or MGet).
+func (c *Cache[K, V]) OnHit(f func(K)) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.onHit = f
+}
+
+// OnMiss registers f to be called, outside of the cache's lock, for every
+// key not found by Get (or MGet), including lazily-expired entries.
+func (c *Cache[K, V]) OnMiss(f func(K)) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.onMiss = f
+}
+
+// OnEvict registers f to be called, outside of the cache's lock, for every
+// entry removed from the cache - equivalent to passing f to
+// NewCacheWithEvict, but usable after construction.
+func (c *Cache[K, V]) OnEvict(f func(K, V)) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.onEvicted = f
+}
+
+// Debug dumps the SIEVE list in order, showing each entry's key, visited
+// bit, and whether the hand currently points at it.
+func (c *Cache[K, V]) Debug() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for curr := c.q.Front(); curr != nil; curr = curr.Next() {
+		entry := curr.Value.(*nodeEntry[K, V])
+		marker := " "
+		if curr == c.hand {
+			marker = ">"
+		}
+		fmt.Printf("%s %v visited=%v\n", marker, entry.key, entry.visited)
+	}
+}
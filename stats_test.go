@@ -0,0 +1,43 @@
+package sieve
+
+import "testing"
+
+func TestCache_Stats(t *testing.T) {
+	cache := NewCache[string, string](2)
+	cache.Set("a", "A")
+	cache.Set("b", "B")
+	cache.Get("a")      // hit
+	cache.Get("x")      // miss
+	cache.Set("c", "C") // evicts one of a/b
+
+	stats := cache.Stats()
+	assertEqual(t, int64(1), stats.Hits, "")
+	assertEqual(t, int64(1), stats.Misses, "")
+	assertEqual(t, int64(3), stats.Insertions, "")
+	assertEqual(t, int64(1), stats.Evictions, "")
+}
+
+func TestCache_OnHitOnMiss(t *testing.T) {
+	var hits, misses []string
+	cache := NewCache[string, string](2)
+	cache.OnHit(func(k string) { hits = append(hits, k) })
+	cache.OnMiss(func(k string) { misses = append(misses, k) })
+
+	cache.Set("a", "A")
+	cache.Get("a")
+	cache.Get("missing")
+
+	assertEqualSlice(t, []string{"a"}, hits, "")
+	assertEqualSlice(t, []string{"missing"}, misses, "")
+}
+
+func TestCache_OnEvict(t *testing.T) {
+	var evicted []string
+	cache := NewCache[string, string](1)
+	cache.OnEvict(func(k, v string) { evicted = append(evicted, k) })
+
+	cache.Set("a", "A")
+	cache.Set("b", "B") // evicts "a"
+
+	assertEqualSlice(t, []string{"a"}, evicted, "")
+}
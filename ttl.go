@@ -0,0 +1,90 @@
+package sieve
+
+import (
+	"container/list"
+	"time"
+)
+
+// NewCacheWithDefaultTTL initializes a new cache with the given capacity
+// where every entry added via Set expires after ttl. Use SetWithTTL to
+// override the expiry for an individual key.
+func NewCacheWithDefaultTTL[K Key, V Value](capacity int64, ttl time.Duration) *Cache[K, V] {
+	cache := NewCache[K, V](capacity)
+	cache.defaultTTL = ttl
+	return cache
+}
+
+// SetWithTTL adds a key-value pair to the cache that expires after ttl,
+// evicting an entry if necessary. A zero ttl means the entry never expires.
+func (c *Cache[K, V]) SetWithTTL(key K, value V, ttl time.Duration) {
+	c.setWithTTL(key, value, ttl)
+}
+
+// janitor periodically purges expired entries from a Cache in the background.
+type janitor struct {
+	interval time.Duration
+	stop     chan struct{}
+	done     chan struct{}
+}
+
+// StartJanitor starts a background goroutine that purges expired entries
+// from the cache every interval. Calling StartJanitor while a janitor is
+// already running stops the previous one first.
+func (c *Cache[K, V]) StartJanitor(interval time.Duration) {
+	c.StopJanitor()
+
+	c.mu.Lock()
+	j := &janitor{interval: interval, stop: make(chan struct{}), done: make(chan struct{})}
+	c.janitor = j
+	c.mu.Unlock()
+
+	go func() {
+		defer close(j.done)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				c.purgeExpired()
+			case <-j.stop:
+				return
+			}
+		}
+	}()
+}
+
+// StopJanitor stops the background janitor started by StartJanitor, if any.
+// It is a no-op if no janitor is running.
+func (c *Cache[K, V]) StopJanitor() {
+	c.mu.Lock()
+	j := c.janitor
+	c.janitor = nil
+	c.mu.Unlock()
+
+	if j == nil {
+		return
+	}
+	close(j.stop)
+	<-j.done
+}
+
+// purgeExpired removes every currently expired entry from the cache,
+// firing the eviction callback for each one outside of the lock.
+func (c *Cache[K, V]) purgeExpired() {
+	c.mu.Lock()
+	var toRemove []*list.Element
+	for curr := c.q.Front(); curr != nil; curr = curr.Next() {
+		entry := curr.Value.(*nodeEntry[K, V])
+		if isExpired(entry) {
+			toRemove = append(toRemove, curr)
+		}
+	}
+	for _, ele := range toRemove {
+		entry := ele.Value.(*nodeEntry[K, V])
+		c.removeElementLocked(ele, entry)
+		c.stats.expirations.Add(1)
+	}
+	keys, values := c.takeEvicted()
+	c.mu.Unlock()
+	c.notifyEvicted(keys, values)
+}
@@ -0,0 +1,76 @@
+package sieve
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCache_SetWithTTLExpires(t *testing.T) {
+	cache := NewCache[string, string](5)
+	cache.SetWithTTL("a", "A", 10*time.Millisecond)
+
+	value, err := cache.Get("a")
+	assertErrorNil(t, err)
+	assertEqual(t, "A", value, "before expiry")
+
+	time.Sleep(20 * time.Millisecond)
+
+	_, err = cache.Get("a")
+	assertErrorEqual(t, ErrKeyNotFound, err)
+	assertEqual(t, int64(0), cache.Size(), "expired entry should be lazily removed on Get")
+}
+
+func TestCache_NewCacheWithDefaultTTL(t *testing.T) {
+	cache := NewCacheWithDefaultTTL[string, string](5, 10*time.Millisecond)
+	cache.Set("a", "A")
+
+	time.Sleep(20 * time.Millisecond)
+
+	_, err := cache.Get("a")
+	assertErrorEqual(t, ErrKeyNotFound, err)
+}
+
+func TestCache_SetWithTTLZeroNeverExpires(t *testing.T) {
+	cache := NewCacheWithDefaultTTL[string, string](5, 10*time.Millisecond)
+	cache.SetWithTTL("a", "A", 0)
+
+	time.Sleep(20 * time.Millisecond)
+
+	value, err := cache.Get("a")
+	assertErrorNil(t, err)
+	assertEqual(t, "A", value, "a zero ttl should override the cache's default and never expire")
+}
+
+func TestCache_JanitorPurgesExpiredEntries(t *testing.T) {
+	var evicted []string
+	cache := NewCacheWithEvict[string, string](5, func(k, v string) {
+		evicted = append(evicted, k)
+	})
+	cache.SetWithTTL("a", "A", 10*time.Millisecond)
+	cache.Set("b", "B") // never expires
+
+	cache.StartJanitor(5 * time.Millisecond)
+	defer cache.StopJanitor()
+
+	time.Sleep(50 * time.Millisecond)
+
+	assertEqualSlice(t, []string{"a"}, evicted, "janitor should purge only the expired key")
+	assertEqual(t, int64(1), cache.Size(), "")
+	assertEqualSlice(t, []string{"b"}, cache.Keys(), "")
+}
+
+func TestCache_StartJanitorReplacesPrevious(t *testing.T) {
+	cache := NewCache[string, string](5)
+	cache.StartJanitor(time.Hour)
+	first := cache.janitor
+
+	cache.StartJanitor(time.Hour)
+	if cache.janitor == first {
+		t.Fatal("expected StartJanitor to replace the previous janitor")
+	}
+
+	cache.StopJanitor()
+	if cache.janitor != nil {
+		t.Fatal("expected StopJanitor to clear the janitor")
+	}
+}